@@ -0,0 +1,94 @@
+package anthropic
+
+import (
+	"context"
+
+	"github.com/anthropics/anthropic-sdk-go"
+)
+
+// CacheControlConfig configures Anthropic prompt-caching `cache_control`
+// breakpoints for a Client. Caching only pays off once a prefix is large
+// enough and reused across calls, so both knobs are opt-in.
+type CacheControlConfig struct {
+	// Enabled turns cache_control breakpoints on. Everything else in this
+	// struct is ignored while false.
+	Enabled bool
+
+	// SystemPromptThreshold is the minimum system prompt length, in bytes,
+	// before a breakpoint is attached to it automatically. Zero marks every
+	// non-empty system prompt as cacheable.
+	SystemPromptThreshold int
+
+	// ConversationTurnBreakpoints marks the last N messages in the
+	// conversation as cache_control breakpoints, so a long shared history
+	// (e.g. multi-turn tool use) can be reused across calls. Zero disables
+	// message-level breakpoints.
+	ConversationTurnBreakpoints int
+}
+
+// cacheControlContextKey is the context key under which a per-request
+// CacheControlConfig override is stored.
+type cacheControlContextKey struct{}
+
+// WithRequestCacheControl overrides the Client's cache_control config for
+// calls made with the returned context. This is a programmatic, Go-level
+// override only: nothing in this package extracts a cache_control override
+// out of an incoming openai.ChatCompletionNewParams (there is no documented
+// extension field for it yet), so a caller wanting per-request control from
+// raw request JSON must parse that field itself and call
+// WithRequestCacheControl before invoking the Client. Per-Client config plus
+// the system-prompt-length heuristic remain the only behavior that applies
+// automatically.
+func WithRequestCacheControl(ctx context.Context, cfg CacheControlConfig) context.Context {
+	return context.WithValue(ctx, cacheControlContextKey{}, cfg)
+}
+
+// cacheControlFor resolves the effective CacheControlConfig for a call,
+// preferring a per-request override set via WithRequestCacheControl over
+// the Client's default.
+func (c *Client) cacheControlFor(ctx context.Context) CacheControlConfig {
+	if cfg, ok := ctx.Value(cacheControlContextKey{}).(CacheControlConfig); ok {
+		return cfg
+	}
+	return c.cacheControl
+}
+
+// ephemeralCacheControl is the `cache_control: {type: "ephemeral"}` marker
+// Anthropic expects on a cacheable block.
+func ephemeralCacheControl() anthropic.CacheControlEphemeralParam {
+	return anthropic.NewCacheControlEphemeralParam()
+}
+
+// applyCacheControlBreakpoints marks the last content block of each of the
+// last n messages as a cache_control breakpoint.
+func applyCacheControlBreakpoints(messages []anthropic.MessageParam, n int) {
+	if n <= 0 {
+		return
+	}
+	start := len(messages) - n
+	if start < 0 {
+		start = 0
+	}
+	for i := start; i < len(messages); i++ {
+		content := messages[i].Content
+		if len(content) == 0 {
+			continue
+		}
+		applyCacheControl(&content[len(content)-1])
+	}
+}
+
+// applyCacheControl sets the ephemeral cache_control marker on whichever
+// content-block variant block actually holds.
+func applyCacheControl(block *anthropic.ContentBlockParamUnion) {
+	switch {
+	case block.OfText != nil:
+		block.OfText.CacheControl = ephemeralCacheControl()
+	case block.OfImage != nil:
+		block.OfImage.CacheControl = ephemeralCacheControl()
+	case block.OfToolUse != nil:
+		block.OfToolUse.CacheControl = ephemeralCacheControl()
+	case block.OfToolResult != nil:
+		block.OfToolResult.CacheControl = ephemeralCacheControl()
+	}
+}
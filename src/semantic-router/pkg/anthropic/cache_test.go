@@ -0,0 +1,140 @@
+package anthropic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/anthropics/anthropic-sdk-go"
+	"github.com/openai/openai-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToAnthropicRequest_CachesLongSystemPrompt(t *testing.T) {
+	client := &Client{cacheControl: CacheControlConfig{Enabled: true, SystemPromptThreshold: 10}}
+
+	req := &openai.ChatCompletionNewParams{
+		Model: "claude-sonnet-4-5",
+		Messages: []openai.ChatCompletionMessageParamUnion{
+			{OfSystem: &openai.ChatCompletionSystemMessageParam{
+				Content: openai.ChatCompletionSystemMessageParamContentUnion{
+					OfString: openai.String("You are a meticulous, detail-oriented assistant."),
+				},
+			}},
+			{OfUser: &openai.ChatCompletionUserMessageParam{
+				Content: openai.ChatCompletionUserMessageParamContentUnion{OfString: openai.String("Hi")},
+			}},
+		},
+	}
+
+	result := client.toAnthropicRequest(context.Background(), req)
+
+	assert.NotZero(t, result.System[0].CacheControl)
+}
+
+func TestToAnthropicRequest_SkipsShortSystemPrompt(t *testing.T) {
+	client := &Client{cacheControl: CacheControlConfig{Enabled: true, SystemPromptThreshold: 1000}}
+
+	req := &openai.ChatCompletionNewParams{
+		Model: "claude-sonnet-4-5",
+		Messages: []openai.ChatCompletionMessageParamUnion{
+			{OfSystem: &openai.ChatCompletionSystemMessageParam{
+				Content: openai.ChatCompletionSystemMessageParamContentUnion{OfString: openai.String("Be terse.")},
+			}},
+			{OfUser: &openai.ChatCompletionUserMessageParam{
+				Content: openai.ChatCompletionUserMessageParamContentUnion{OfString: openai.String("Hi")},
+			}},
+		},
+	}
+
+	result := client.toAnthropicRequest(context.Background(), req)
+
+	assert.Zero(t, result.System[0].CacheControl)
+}
+
+func TestToAnthropicRequest_DisabledCachingLeavesSystemPromptAlone(t *testing.T) {
+	client := &Client{}
+
+	req := &openai.ChatCompletionNewParams{
+		Model: "claude-sonnet-4-5",
+		Messages: []openai.ChatCompletionMessageParamUnion{
+			{OfSystem: &openai.ChatCompletionSystemMessageParam{
+				Content: openai.ChatCompletionSystemMessageParamContentUnion{
+					OfString: openai.String("You are a meticulous, detail-oriented assistant."),
+				},
+			}},
+			{OfUser: &openai.ChatCompletionUserMessageParam{
+				Content: openai.ChatCompletionUserMessageParamContentUnion{OfString: openai.String("Hi")},
+			}},
+		},
+	}
+
+	result := client.toAnthropicRequest(context.Background(), req)
+
+	assert.Zero(t, result.System[0].CacheControl)
+}
+
+func TestToAnthropicRequest_PerRequestCacheControlOverridesClient(t *testing.T) {
+	client := &Client{cacheControl: CacheControlConfig{Enabled: true, SystemPromptThreshold: 1000}}
+
+	req := &openai.ChatCompletionNewParams{
+		Model: "claude-sonnet-4-5",
+		Messages: []openai.ChatCompletionMessageParamUnion{
+			{OfSystem: &openai.ChatCompletionSystemMessageParam{
+				Content: openai.ChatCompletionSystemMessageParamContentUnion{
+					OfString: openai.String("You are a meticulous, detail-oriented assistant."),
+				},
+			}},
+			{OfUser: &openai.ChatCompletionUserMessageParam{
+				Content: openai.ChatCompletionUserMessageParamContentUnion{OfString: openai.String("Hi")},
+			}},
+		},
+	}
+
+	// The Client's default threshold (1000) would skip caching this short
+	// system prompt; a per-request override with a low threshold turns it on.
+	ctx := WithRequestCacheControl(context.Background(), CacheControlConfig{Enabled: true, SystemPromptThreshold: 1})
+	result := client.toAnthropicRequest(ctx, req)
+
+	assert.NotZero(t, result.System[0].CacheControl)
+}
+
+func TestCacheControlFor_FallsBackToClientDefault(t *testing.T) {
+	client := &Client{cacheControl: CacheControlConfig{Enabled: true, SystemPromptThreshold: 42}}
+
+	assert.Equal(t, client.cacheControl, client.cacheControlFor(context.Background()))
+}
+
+func TestApplyCacheControlBreakpoints_MarksLastNMessages(t *testing.T) {
+	messages := []anthropic.MessageParam{
+		anthropic.NewUserMessage(anthropic.NewTextBlock("turn 1")),
+		anthropic.NewUserMessage(anthropic.NewTextBlock("turn 2")),
+		anthropic.NewUserMessage(anthropic.NewTextBlock("turn 3")),
+	}
+
+	applyCacheControlBreakpoints(messages, 2)
+
+	assert.Zero(t, messages[0].Content[0].OfText.CacheControl)
+	assert.NotZero(t, messages[1].Content[0].OfText.CacheControl)
+	assert.NotZero(t, messages[2].Content[0].OfText.CacheControl)
+}
+
+func TestToOpenAIResponse_SurfacesCacheUsage(t *testing.T) {
+	client := &Client{}
+
+	anthropicResp := &anthropic.Message{
+		ID:         "msg_123",
+		Content:    []anthropic.ContentBlockUnion{{Type: "text", Text: "Hi"}},
+		StopReason: anthropic.StopReasonEndTurn,
+		Usage: anthropic.Usage{
+			InputTokens:              10,
+			OutputTokens:             5,
+			CacheCreationInputTokens: 200,
+			CacheReadInputTokens:     800,
+		},
+	}
+
+	result := client.toOpenAIResponse(anthropicResp, "claude-sonnet-4-5")
+
+	assert.Equal(t, 200, result.Usage.CacheCreationInputTokens)
+	assert.Equal(t, 800, result.Usage.CacheReadInputTokens)
+}
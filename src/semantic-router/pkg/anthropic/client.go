@@ -12,6 +12,7 @@ import (
 	"github.com/anthropics/anthropic-sdk-go"
 	"github.com/anthropics/anthropic-sdk-go/option"
 	"github.com/openai/openai-go"
+	"github.com/vllm-project/semantic-router/src/semantic-router/pkg/providers"
 )
 
 // DefaultMaxTokens is the default max tokens if not specified in request
@@ -20,45 +21,90 @@ const DefaultMaxTokens int64 = 4096
 // Client wraps the Anthropic SDK and provides OpenAI-compatible interface
 type Client struct {
 	sdk anthropic.Client
+
+	observer     UsageObserver
+	prices       PriceTable
+	cacheControl CacheControlConfig
+}
+
+// Client implements providers.Provider.
+var _ providers.Provider = (*Client)(nil)
+
+// ClientOption configures optional behavior on a Client.
+type ClientOption func(*Client)
+
+// WithUsageObserver registers an observer notified with a UsageRecord after
+// every completed call, blocking or streamed.
+func WithUsageObserver(observer UsageObserver) ClientOption {
+	return func(c *Client) { c.observer = observer }
+}
+
+// WithPriceTable sets the per-model USD pricing used to compute Cost on
+// each UsageRecord. Without one, Cost is always 0.
+func WithPriceTable(prices PriceTable) ClientOption {
+	return func(c *Client) { c.prices = prices }
+}
+
+// WithPromptCaching enables Anthropic prompt-caching cache_control
+// breakpoints according to cfg.
+func WithPromptCaching(cfg CacheControlConfig) ClientOption {
+	return func(c *Client) { c.cacheControl = cfg }
 }
 
 // NewClient creates a new Anthropic client
-func NewClient(apiKey string) *Client {
-	return &Client{
+func NewClient(apiKey string, opts ...ClientOption) *Client {
+	c := &Client{
 		sdk: anthropic.NewClient(option.WithAPIKey(apiKey)),
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 // ChatCompletion processes an OpenAI-format request and returns an OpenAI-format response
 func (c *Client) ChatCompletion(ctx context.Context, req *openai.ChatCompletionNewParams) ([]byte, error) {
+	start := time.Now()
+
 	// Convert and call Anthropic API
-	anthropicReq := c.toAnthropicRequest(req)
+	anthropicReq := c.toAnthropicRequest(ctx, req)
 
 	resp, err := c.sdk.Messages.New(ctx, anthropicReq)
 	if err != nil {
 		return nil, fmt.Errorf("anthropic API error: %w", err)
 	}
 
+	c.recordUsage(req.Model, resp.Usage, time.Since(start))
+
 	// Convert response to OpenAI format and serialize
 	return json.Marshal(c.toOpenAIResponse(resp, req.Model))
 }
 
 // toAnthropicRequest converts OpenAI request to Anthropic format
-func (c *Client) toAnthropicRequest(req *openai.ChatCompletionNewParams) anthropic.MessageNewParams {
+func (c *Client) toAnthropicRequest(ctx context.Context, req *openai.ChatCompletionNewParams) anthropic.MessageNewParams {
 	var messages []anthropic.MessageParam
 	var systemPrompt string
 
-	// Process messages - extract system prompt separately (Anthropic requirement)
-	for _, msg := range req.Messages {
+	// Process messages - extract system prompt separately (Anthropic requirement).
+	// Consecutive role=tool messages (parallel tool calls) are collapsed into a
+	// single user message, since Anthropic requires strict role alternation.
+	for i := 0; i < len(req.Messages); i++ {
+		msg := req.Messages[i]
 		switch {
 		case msg.OfSystem != nil:
 			systemPrompt = c.extractSystemContent(msg.OfSystem)
 		case msg.OfUser != nil:
-			content := c.extractUserContent(msg.OfUser)
-			messages = append(messages, anthropic.NewUserMessage(anthropic.NewTextBlock(content)))
+			blocks := c.extractUserContent(msg.OfUser)
+			messages = append(messages, anthropic.NewUserMessage(blocks...))
 		case msg.OfAssistant != nil:
-			content := c.extractAssistantContent(msg.OfAssistant)
-			messages = append(messages, anthropic.NewAssistantMessage(anthropic.NewTextBlock(content)))
+			messages = append(messages, c.toAnthropicAssistantMessage(msg.OfAssistant))
+		case msg.OfTool != nil:
+			toolMsgs := []*openai.ChatCompletionToolMessageParam{msg.OfTool}
+			for i+1 < len(req.Messages) && req.Messages[i+1].OfTool != nil {
+				i++
+				toolMsgs = append(toolMsgs, req.Messages[i].OfTool)
+			}
+			messages = append(messages, c.toAnthropicToolResultMessage(toolMsgs))
 		}
 	}
 
@@ -77,10 +123,17 @@ func (c *Client) toAnthropicRequest(req *openai.ChatCompletionNewParams) anthrop
 	}
 
 	// Set system prompt if present
+	cacheControl := c.cacheControlFor(ctx)
 	if systemPrompt != "" {
-		params.System = []anthropic.TextBlockParam{
-			{Text: systemPrompt},
+		systemBlock := anthropic.TextBlockParam{Text: systemPrompt}
+		if cacheControl.Enabled && len(systemPrompt) >= cacheControl.SystemPromptThreshold {
+			systemBlock.CacheControl = ephemeralCacheControl()
 		}
+		params.System = []anthropic.TextBlockParam{systemBlock}
+	}
+
+	if cacheControl.Enabled {
+		applyCacheControlBreakpoints(params.Messages, cacheControl.ConversationTurnBreakpoints)
 	}
 
 	// Set optional parameters
@@ -96,6 +149,14 @@ func (c *Client) toAnthropicRequest(req *openai.ChatCompletionNewParams) anthrop
 		params.StopSequences = []string{req.Stop.OfString.Value}
 	}
 
+	// Set tools and tool_choice if present
+	if tools := c.toAnthropicTools(req.Tools); tools != nil {
+		params.Tools = tools
+	}
+	if toolChoice := c.toAnthropicToolChoice(req.ToolChoice); toolChoice != nil {
+		params.ToolChoice = *toolChoice
+	}
+
 	return params
 }
 
@@ -113,20 +174,6 @@ func (c *Client) extractSystemContent(msg *openai.ChatCompletionSystemMessagePar
 	return strings.Join(parts, " ")
 }
 
-// extractUserContent extracts text from a user message
-func (c *Client) extractUserContent(msg *openai.ChatCompletionUserMessageParam) string {
-	if msg.Content.OfString.Value != "" {
-		return msg.Content.OfString.Value
-	}
-	var parts []string
-	for _, part := range msg.Content.OfArrayOfContentParts {
-		if part.OfText != nil {
-			parts = append(parts, part.OfText.Text)
-		}
-	}
-	return strings.Join(parts, " ")
-}
-
 // extractAssistantContent extracts text from an assistant message
 func (c *Client) extractAssistantContent(msg *openai.ChatCompletionAssistantMessageParam) string {
 	if msg.Content.OfString.Value != "" {
@@ -158,34 +205,69 @@ type openAIChoice struct {
 }
 
 type openAIMessage struct {
-	Role    string `json:"role"`
-	Content string `json:"content"`
+	Role      string           `json:"role"`
+	Content   string           `json:"content"`
+	ToolCalls []openAIToolCall `json:"tool_calls,omitempty"`
+}
+
+type openAIToolCall struct {
+	ID       string             `json:"id"`
+	Type     string             `json:"type"`
+	Function openAIToolCallFunc `json:"function"`
+}
+
+type openAIToolCallFunc struct {
+	Name      string `json:"name"`
+	Arguments string `json:"arguments"`
 }
 
 type openAIUsage struct {
 	PromptTokens     int `json:"prompt_tokens"`
 	CompletionTokens int `json:"completion_tokens"`
 	TotalTokens      int `json:"total_tokens"`
+
+	// Vendor-specific extensions surfacing Anthropic prompt-cache hit rates;
+	// zero (and thus omitted) for calls that didn't touch the cache.
+	CacheCreationInputTokens int `json:"cache_creation_input_tokens,omitempty"`
+	CacheReadInputTokens     int `json:"cache_read_input_tokens,omitempty"`
+}
+
+// mapStopReason maps an Anthropic stop reason to its OpenAI finish_reason
+// equivalent.
+func mapStopReason(reason anthropic.StopReason) string {
+	switch reason {
+	case anthropic.StopReasonMaxTokens:
+		return "length"
+	case anthropic.StopReasonToolUse:
+		return "tool_calls"
+	default:
+		return "stop"
+	}
 }
 
 // toOpenAIResponse converts Anthropic response to OpenAI format
 func (c *Client) toOpenAIResponse(resp *anthropic.Message, model string) *openAIResponse {
-	// Extract text content
+	// Extract text content and any tool calls
 	var content string
+	var toolCalls []openAIToolCall
 	for _, block := range resp.Content {
-		if block.Type == "text" {
+		switch block.Type {
+		case "text":
 			content += block.Text
+		case "tool_use":
+			arguments, _ := json.Marshal(block.Input)
+			toolCalls = append(toolCalls, openAIToolCall{
+				ID:   block.ID,
+				Type: "function",
+				Function: openAIToolCallFunc{
+					Name:      block.Name,
+					Arguments: string(arguments),
+				},
+			})
 		}
 	}
 
-	// Map stop reason
-	finishReason := "stop"
-	switch resp.StopReason {
-	case anthropic.StopReasonMaxTokens:
-		finishReason = "length"
-	case anthropic.StopReasonToolUse:
-		finishReason = "tool_calls"
-	}
+	finishReason := mapStopReason(resp.StopReason)
 
 	return &openAIResponse{
 		ID:      resp.ID,
@@ -194,13 +276,15 @@ func (c *Client) toOpenAIResponse(resp *anthropic.Message, model string) *openAI
 		Model:   model,
 		Choices: []openAIChoice{{
 			Index:        0,
-			Message:      openAIMessage{Role: "assistant", Content: content},
+			Message:      openAIMessage{Role: "assistant", Content: content, ToolCalls: toolCalls},
 			FinishReason: finishReason,
 		}},
 		Usage: openAIUsage{
-			PromptTokens:     int(resp.Usage.InputTokens),
-			CompletionTokens: int(resp.Usage.OutputTokens),
-			TotalTokens:      int(resp.Usage.InputTokens + resp.Usage.OutputTokens),
+			PromptTokens:             int(resp.Usage.InputTokens),
+			CompletionTokens:         int(resp.Usage.OutputTokens),
+			TotalTokens:              int(resp.Usage.InputTokens + resp.Usage.OutputTokens),
+			CacheCreationInputTokens: int(resp.Usage.CacheCreationInputTokens),
+			CacheReadInputTokens:     int(resp.Usage.CacheReadInputTokens),
 		},
 	}
 }
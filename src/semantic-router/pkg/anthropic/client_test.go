@@ -1,6 +1,8 @@
 package anthropic
 
 import (
+	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/anthropics/anthropic-sdk-go"
@@ -22,7 +24,7 @@ func TestToAnthropicRequest_BasicConversion(t *testing.T) {
 		},
 	}
 
-	result := client.toAnthropicRequest(req)
+	result := client.toAnthropicRequest(context.Background(), req)
 
 	assert.Equal(t, anthropic.Model("claude-sonnet-4-5"), result.Model)
 	assert.Equal(t, DefaultMaxTokens, result.MaxTokens)
@@ -48,7 +50,7 @@ func TestToAnthropicRequest_WithSystemPrompt(t *testing.T) {
 		},
 	}
 
-	result := client.toAnthropicRequest(req)
+	result := client.toAnthropicRequest(context.Background(), req)
 
 	assert.Len(t, result.System, 1)
 	assert.Equal(t, "You are a helpful assistant.", result.System[0].Text)
@@ -70,7 +72,7 @@ func TestToAnthropicRequest_WithMaxTokens(t *testing.T) {
 		},
 	}
 
-	result := client.toAnthropicRequest(req)
+	result := client.toAnthropicRequest(context.Background(), req)
 
 	assert.Equal(t, int64(1024), result.MaxTokens)
 }
@@ -94,7 +96,7 @@ func TestToAnthropicRequest_WithOptionalParams(t *testing.T) {
 		},
 	}
 
-	result := client.toAnthropicRequest(req)
+	result := client.toAnthropicRequest(context.Background(), req)
 
 	assert.NotNil(t, result.Temperature)
 	assert.NotNil(t, result.TopP)
@@ -116,7 +118,7 @@ func TestToAnthropicRequest_WithZeroTemperature(t *testing.T) {
 		},
 	}
 
-	result := client.toAnthropicRequest(req)
+	result := client.toAnthropicRequest(context.Background(), req)
 
 	// Temperature should be set even when 0.0
 	assert.True(t, result.Temperature.Valid())
@@ -147,7 +149,7 @@ func TestToAnthropicRequest_MultiTurnConversation(t *testing.T) {
 		},
 	}
 
-	result := client.toAnthropicRequest(req)
+	result := client.toAnthropicRequest(context.Background(), req)
 
 	assert.Len(t, result.Messages, 3)
 }
@@ -245,32 +247,144 @@ func TestExtractSystemContent_StringContent(t *testing.T) {
 	assert.Equal(t, "You are helpful.", result)
 }
 
-func TestExtractUserContent_StringContent(t *testing.T) {
+func TestExtractAssistantContent_StringContent(t *testing.T) {
 	client := &Client{}
 
-	msg := &openai.ChatCompletionUserMessageParam{
-		Content: openai.ChatCompletionUserMessageParamContentUnion{
-			OfString: openai.String("Hello there!"),
+	msg := &openai.ChatCompletionAssistantMessageParam{
+		Content: openai.ChatCompletionAssistantMessageParamContentUnion{
+			OfString: openai.String("Hi! How can I help?"),
 		},
 	}
 
-	result := client.extractUserContent(msg)
+	result := client.extractAssistantContent(msg)
 
-	assert.Equal(t, "Hello there!", result)
+	assert.Equal(t, "Hi! How can I help?", result)
 }
 
-func TestExtractAssistantContent_StringContent(t *testing.T) {
+func TestToAnthropicRequest_WithTools(t *testing.T) {
 	client := &Client{}
 
-	msg := &openai.ChatCompletionAssistantMessageParam{
-		Content: openai.ChatCompletionAssistantMessageParamContentUnion{
-			OfString: openai.String("Hi! How can I help?"),
+	req := &openai.ChatCompletionNewParams{
+		Model: "claude-sonnet-4-5",
+		Tools: []openai.ChatCompletionToolParam{
+			{
+				Function: openai.FunctionDefinitionParam{
+					Name:        "get_weather",
+					Description: openai.String("Get the current weather for a location"),
+					Parameters: openai.FunctionParameters{
+						"type": "object",
+						"properties": map[string]any{
+							"location": map[string]any{"type": "string"},
+						},
+						"required": []string{"location"},
+					},
+				},
+			},
+		},
+		ToolChoice: openai.ChatCompletionToolChoiceOptionUnionParam{
+			OfAuto: openai.String("auto"),
+		},
+		Messages: []openai.ChatCompletionMessageParamUnion{
+			{OfUser: &openai.ChatCompletionUserMessageParam{
+				Content: openai.ChatCompletionUserMessageParamContentUnion{
+					OfString: openai.String("What's the weather in Paris?"),
+				},
+			}},
 		},
 	}
 
-	result := client.extractAssistantContent(msg)
+	result := client.toAnthropicRequest(context.Background(), req)
 
-	assert.Equal(t, "Hi! How can I help?", result)
+	assert.Len(t, result.Tools, 1)
+	assert.Equal(t, "get_weather", result.Tools[0].OfTool.Name)
+	assert.NotNil(t, result.ToolChoice.OfAuto)
+
+	schema := result.Tools[0].OfTool.InputSchema
+	assert.Equal(t, map[string]any{"location": map[string]any{"type": "string"}}, schema.Properties)
+	assert.Equal(t, []string{"location"}, schema.ExtraFields["required"])
+}
+
+func TestToAnthropicRequest_MultiTurnToolWorkflow(t *testing.T) {
+	client := &Client{}
+
+	req := &openai.ChatCompletionNewParams{
+		Model: "claude-sonnet-4-5",
+		Messages: []openai.ChatCompletionMessageParamUnion{
+			{OfUser: &openai.ChatCompletionUserMessageParam{
+				Content: openai.ChatCompletionUserMessageParamContentUnion{
+					OfString: openai.String("What's the weather in Paris, and in London?"),
+				},
+			}},
+			{OfAssistant: &openai.ChatCompletionAssistantMessageParam{
+				ToolCalls: []openai.ChatCompletionMessageToolCallParam{
+					{
+						ID: "toolu_123",
+						Function: openai.ChatCompletionMessageToolCallFunctionParam{
+							Name:      "get_weather",
+							Arguments: `{"location":"Paris"}`,
+						},
+					},
+					{
+						ID: "toolu_124",
+						Function: openai.ChatCompletionMessageToolCallFunctionParam{
+							Name:      "get_weather",
+							Arguments: `{"location":"London"}`,
+						},
+					},
+				},
+			}},
+			{OfTool: &openai.ChatCompletionToolMessageParam{
+				ToolCallID: "toolu_123",
+				Content: openai.ChatCompletionToolMessageParamContentUnion{
+					OfString: openai.String("15C and sunny"),
+				},
+			}},
+			{OfTool: &openai.ChatCompletionToolMessageParam{
+				ToolCallID: "toolu_124",
+				Content: openai.ChatCompletionToolMessageParamContentUnion{
+					OfString: openai.String("12C and rainy"),
+				},
+			}},
+		},
+	}
+
+	result := client.toAnthropicRequest(context.Background(), req)
+
+	// user, assistant (two tool_use blocks), and a single user message
+	// collapsing both parallel tool_result blocks - never two consecutive
+	// user-role messages.
+	assert.Len(t, result.Messages, 3)
+
+	assistantMsg := result.Messages[1]
+	assert.Len(t, assistantMsg.Content, 2)
+	assert.Equal(t, "toolu_123", assistantMsg.Content[0].OfToolUse.ID)
+	assert.Equal(t, "toolu_124", assistantMsg.Content[1].OfToolUse.ID)
+
+	toolResultMsg := result.Messages[2]
+	assert.Len(t, toolResultMsg.Content, 2)
+	assert.Equal(t, "toolu_123", toolResultMsg.Content[0].OfToolResult.ToolUseID)
+	assert.Equal(t, "toolu_124", toolResultMsg.Content[1].OfToolResult.ToolUseID)
+}
+
+func TestToOpenAIResponse_ToolUseContentBlock(t *testing.T) {
+	client := &Client{}
+
+	anthropicResp := &anthropic.Message{
+		ID: "msg_123",
+		Content: []anthropic.ContentBlockUnion{
+			{Type: "tool_use", ID: "toolu_123", Name: "get_weather", Input: json.RawMessage(`{"location":"Paris"}`)},
+		},
+		StopReason: anthropic.StopReasonToolUse,
+		Usage:      anthropic.Usage{InputTokens: 10, OutputTokens: 20},
+	}
+
+	result := client.toOpenAIResponse(anthropicResp, "claude-sonnet-4-5")
+
+	assert.Equal(t, "tool_calls", result.Choices[0].FinishReason)
+	assert.Len(t, result.Choices[0].Message.ToolCalls, 1)
+	assert.Equal(t, "toolu_123", result.Choices[0].Message.ToolCalls[0].ID)
+	assert.Equal(t, "get_weather", result.Choices[0].Message.ToolCalls[0].Function.Name)
+	assert.JSONEq(t, `{"location":"Paris"}`, result.Choices[0].Message.ToolCalls[0].Function.Arguments)
 }
 
 func TestNewClient(t *testing.T) {
@@ -0,0 +1,39 @@
+package anthropic
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	inputTokensTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "semantic_router_anthropic_input_tokens_total",
+		Help: "Total input tokens sent to Anthropic, by model.",
+	}, []string{"model"})
+
+	outputTokensTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "semantic_router_anthropic_output_tokens_total",
+		Help: "Total output tokens received from Anthropic, by model.",
+	}, []string{"model"})
+
+	costUSDTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "semantic_router_anthropic_cost_usd_total",
+		Help: "Total estimated USD cost of Anthropic requests, by model.",
+	}, []string{"model"})
+
+	requestLatencySeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "semantic_router_anthropic_request_latency_seconds",
+		Help:    "Anthropic request latency in seconds, by model.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"model"})
+)
+
+// observeMetrics records a UsageRecord's token counts, cost, and latency as
+// Prometheus metrics, so the router can enforce per-tenant budgets and emit
+// dashboards off standard /metrics scraping.
+func observeMetrics(record UsageRecord) {
+	inputTokensTotal.WithLabelValues(record.Model).Add(float64(record.InputTokens))
+	outputTokensTotal.WithLabelValues(record.Model).Add(float64(record.OutputTokens))
+	costUSDTotal.WithLabelValues(record.Model).Add(record.Cost)
+	requestLatencySeconds.WithLabelValues(record.Model).Observe(record.Latency.Seconds())
+}
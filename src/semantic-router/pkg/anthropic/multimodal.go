@@ -0,0 +1,59 @@
+package anthropic
+
+import (
+	"strings"
+
+	"github.com/anthropics/anthropic-sdk-go"
+	"github.com/openai/openai-go"
+)
+
+// extractUserContent converts a user message into Anthropic content blocks,
+// preserving the order of interleaved text and image parts so that vision
+// requests routed to Claude carry the pixels, not just a flattened string.
+func (c *Client) extractUserContent(msg *openai.ChatCompletionUserMessageParam) []anthropic.ContentBlockParamUnion {
+	if msg.Content.OfString.Value != "" {
+		return []anthropic.ContentBlockParamUnion{anthropic.NewTextBlock(msg.Content.OfString.Value)}
+	}
+
+	var blocks []anthropic.ContentBlockParamUnion
+	for _, part := range msg.Content.OfArrayOfContentParts {
+		switch {
+		case part.OfText != nil:
+			blocks = append(blocks, anthropic.NewTextBlock(part.OfText.Text))
+		case part.OfImageURL != nil:
+			blocks = append(blocks, toAnthropicImageBlock(part.OfImageURL.ImageURL.URL))
+		}
+	}
+	return blocks
+}
+
+// toAnthropicImageBlock builds an Anthropic image block from an OpenAI
+// image_url part, which may be a data URI (base64-encoded, inline) or a
+// plain remote URL.
+func toAnthropicImageBlock(url string) anthropic.ContentBlockParamUnion {
+	if mediaType, data, ok := parseDataURI(url); ok {
+		return anthropic.NewImageBlockBase64(mediaType, data)
+	}
+	return anthropic.ContentBlockParamUnion{
+		OfImage: &anthropic.ImageBlockParam{
+			Source: anthropic.ImageBlockParamSourceUnion{
+				OfURL: &anthropic.URLImageSourceParam{URL: url},
+			},
+		},
+	}
+}
+
+// parseDataURI splits a "data:<media-type>;base64,<payload>" URI into its
+// media type and base64 payload. ok is false for anything else (including
+// plain remote URLs), which the caller treats as a URL-sourced image.
+func parseDataURI(uri string) (mediaType, data string, ok bool) {
+	rest, found := strings.CutPrefix(uri, "data:")
+	if !found {
+		return "", "", false
+	}
+	mediaType, data, found = strings.Cut(rest, ";base64,")
+	if !found {
+		return "", "", false
+	}
+	return mediaType, data, true
+}
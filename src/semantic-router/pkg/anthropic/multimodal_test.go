@@ -0,0 +1,80 @@
+package anthropic
+
+import (
+	"testing"
+
+	"github.com/openai/openai-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDataURI(t *testing.T) {
+	mediaType, data, ok := parseDataURI("data:image/png;base64,iVBORw0KGgo=")
+	assert.True(t, ok)
+	assert.Equal(t, "image/png", mediaType)
+	assert.Equal(t, "iVBORw0KGgo=", data)
+}
+
+func TestParseDataURI_RemoteURL(t *testing.T) {
+	_, _, ok := parseDataURI("https://example.com/cat.png")
+	assert.False(t, ok)
+}
+
+func TestExtractUserContent_StringContent(t *testing.T) {
+	client := &Client{}
+
+	msg := &openai.ChatCompletionUserMessageParam{
+		Content: openai.ChatCompletionUserMessageParamContentUnion{
+			OfString: openai.String("Hello there!"),
+		},
+	}
+
+	blocks := client.extractUserContent(msg)
+
+	assert.Len(t, blocks, 1)
+	assert.Equal(t, "Hello there!", blocks[0].OfText.Text)
+}
+
+func TestExtractUserContent_InterleavedTextAndImage(t *testing.T) {
+	client := &Client{}
+
+	msg := &openai.ChatCompletionUserMessageParam{
+		Content: openai.ChatCompletionUserMessageParamContentUnion{
+			OfArrayOfContentParts: []openai.ChatCompletionContentPartUnionParam{
+				{OfText: &openai.ChatCompletionContentPartTextParam{Text: "What's in this image?"}},
+				{OfImageURL: &openai.ChatCompletionContentPartImageParam{
+					ImageURL: openai.ChatCompletionContentPartImageImageURLParam{
+						URL: "data:image/jpeg;base64,/9j/4AAQSkZJRg==",
+					},
+				}},
+			},
+		},
+	}
+
+	blocks := client.extractUserContent(msg)
+
+	assert.Len(t, blocks, 2)
+	assert.Equal(t, "What's in this image?", blocks[0].OfText.Text)
+	assert.NotNil(t, blocks[1].OfImage)
+	assert.Equal(t, "image/jpeg", string(blocks[1].OfImage.Source.OfBase64.MediaType))
+}
+
+func TestExtractUserContent_RemoteImageURL(t *testing.T) {
+	client := &Client{}
+
+	msg := &openai.ChatCompletionUserMessageParam{
+		Content: openai.ChatCompletionUserMessageParamContentUnion{
+			OfArrayOfContentParts: []openai.ChatCompletionContentPartUnionParam{
+				{OfImageURL: &openai.ChatCompletionContentPartImageParam{
+					ImageURL: openai.ChatCompletionContentPartImageImageURLParam{
+						URL: "https://example.com/cat.png",
+					},
+				}},
+			},
+		},
+	}
+
+	blocks := client.extractUserContent(msg)
+
+	assert.Len(t, blocks, 1)
+	assert.Equal(t, "https://example.com/cat.png", blocks[0].OfImage.Source.OfURL.URL)
+}
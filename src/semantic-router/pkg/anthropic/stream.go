@@ -0,0 +1,178 @@
+package anthropic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/anthropics/anthropic-sdk-go"
+	"github.com/openai/openai-go"
+)
+
+// streamDoneSentinel is the terminal frame of an OpenAI-format SSE stream.
+const streamDoneSentinel = "data: [DONE]\n\n"
+
+// openAIStreamChunk is a single `chat.completion.chunk` frame.
+type openAIStreamChunk struct {
+	ID      string               `json:"id"`
+	Object  string               `json:"object"`
+	Created int64                `json:"created"`
+	Model   string               `json:"model"`
+	Choices []openAIStreamChoice `json:"choices"`
+	Usage   *openAIUsage         `json:"usage,omitempty"`
+}
+
+type openAIStreamChoice struct {
+	Index        int               `json:"index"`
+	Delta        openAIStreamDelta `json:"delta"`
+	FinishReason *string           `json:"finish_reason"`
+}
+
+type openAIStreamDelta struct {
+	Role      string                      `json:"role,omitempty"`
+	Content   string                      `json:"content,omitempty"`
+	ToolCalls []openAIStreamToolCallDelta `json:"tool_calls,omitempty"`
+}
+
+type openAIStreamToolCallDelta struct {
+	Index    int                                `json:"index"`
+	ID       string                             `json:"id,omitempty"`
+	Type     string                             `json:"type,omitempty"`
+	Function *openAIStreamToolCallFunctionDelta `json:"function,omitempty"`
+}
+
+type openAIStreamToolCallFunctionDelta struct {
+	Name      string `json:"name,omitempty"`
+	Arguments string `json:"arguments,omitempty"`
+}
+
+// streamState accumulates the bookkeeping ChatCompletionStream needs across
+// a run of Anthropic stream events: the message id, the mapping from
+// Anthropic content-block index to OpenAI tool_calls position, the usage
+// reported by message_start, and the finish_reason and output token count
+// carried in the trailing message_delta event.
+type streamState struct {
+	id              string
+	created         int64
+	toolCallIndexes map[int64]int
+	finishReason    string
+	usage           anthropic.Usage
+}
+
+func newStreamState(created int64) *streamState {
+	return &streamState{created: created, toolCallIndexes: map[int64]int{}, finishReason: "stop"}
+}
+
+// ChatCompletionStream processes an OpenAI-format request with streaming
+// enabled. It drives the Anthropic SDK's server-sent event stream and emits
+// OpenAI-format `chat.completion.chunk` frames on chunks as each Anthropic
+// event arrives, finishing with a `data: [DONE]` sentinel.
+func (c *Client) ChatCompletionStream(ctx context.Context, req *openai.ChatCompletionNewParams, chunks chan<- []byte) error {
+	callStart := time.Now()
+	anthropicReq := c.toAnthropicRequest(ctx, req)
+	stream := c.sdk.Messages.NewStreaming(ctx, anthropicReq)
+
+	state := newStreamState(time.Now().Unix())
+	for stream.Next() {
+		if err := c.handleStreamEvent(stream.Current(), req.Model, state, chunks); err != nil {
+			return err
+		}
+	}
+	if err := stream.Err(); err != nil {
+		return fmt.Errorf("anthropic stream error: %w", err)
+	}
+
+	c.recordUsage(req.Model, state.usage, time.Since(callStart))
+
+	if err := c.emitChunk(chunks, state.id, req.Model, state.created, openAIStreamDelta{}, &state.finishReason); err != nil {
+		return err
+	}
+
+	usageChunk := openAIStreamChunk{
+		ID:      state.id,
+		Object:  "chat.completion.chunk",
+		Created: state.created,
+		Model:   req.Model,
+		Choices: []openAIStreamChoice{},
+		Usage: &openAIUsage{
+			PromptTokens:             int(state.usage.InputTokens),
+			CompletionTokens:         int(state.usage.OutputTokens),
+			TotalTokens:              int(state.usage.InputTokens + state.usage.OutputTokens),
+			CacheCreationInputTokens: int(state.usage.CacheCreationInputTokens),
+			CacheReadInputTokens:     int(state.usage.CacheReadInputTokens),
+		},
+	}
+	body, err := json.Marshal(usageChunk)
+	if err != nil {
+		return fmt.Errorf("marshal usage chunk: %w", err)
+	}
+	chunks <- []byte(fmt.Sprintf("data: %s\n\n", body))
+	chunks <- []byte(streamDoneSentinel)
+	return nil
+}
+
+// handleStreamEvent processes a single Anthropic stream event, updating
+// state and emitting any resulting OpenAI-format chunk(s).
+func (c *Client) handleStreamEvent(event anthropic.MessageStreamEventUnion, model string, state *streamState, chunks chan<- []byte) error {
+	switch event.Type {
+	case "message_start":
+		state.id = event.Message.ID
+		state.usage = event.Message.Usage
+		return c.emitChunk(chunks, state.id, model, state.created, openAIStreamDelta{Role: "assistant"}, nil)
+	case "content_block_start":
+		if event.ContentBlock.Type != "tool_use" {
+			return nil
+		}
+		toolIndex := len(state.toolCallIndexes)
+		state.toolCallIndexes[event.Index] = toolIndex
+		delta := openAIStreamDelta{
+			ToolCalls: []openAIStreamToolCallDelta{{
+				Index:    toolIndex,
+				ID:       event.ContentBlock.ID,
+				Type:     "function",
+				Function: &openAIStreamToolCallFunctionDelta{Name: event.ContentBlock.Name},
+			}},
+		}
+		return c.emitChunk(chunks, state.id, model, state.created, delta, nil)
+	case "content_block_delta":
+		switch event.Delta.Type {
+		case "text_delta":
+			delta := openAIStreamDelta{Content: event.Delta.Text}
+			return c.emitChunk(chunks, state.id, model, state.created, delta, nil)
+		case "input_json_delta":
+			toolIndex, ok := state.toolCallIndexes[event.Index]
+			if !ok {
+				return nil
+			}
+			delta := openAIStreamDelta{
+				ToolCalls: []openAIStreamToolCallDelta{{
+					Index:    toolIndex,
+					Function: &openAIStreamToolCallFunctionDelta{Arguments: event.Delta.PartialJSON},
+				}},
+			}
+			return c.emitChunk(chunks, state.id, model, state.created, delta, nil)
+		}
+	case "message_delta":
+		state.finishReason = mapStopReason(event.Delta.StopReason)
+		state.usage.OutputTokens = event.Usage.OutputTokens
+	}
+	return nil
+}
+
+// emitChunk serializes and sends a single chat.completion.chunk SSE frame.
+func (c *Client) emitChunk(chunks chan<- []byte, id, model string, created int64, delta openAIStreamDelta, finishReason *string) error {
+	chunk := openAIStreamChunk{
+		ID:      id,
+		Object:  "chat.completion.chunk",
+		Created: created,
+		Model:   model,
+		Choices: []openAIStreamChoice{{Index: 0, Delta: delta, FinishReason: finishReason}},
+	}
+	body, err := json.Marshal(chunk)
+	if err != nil {
+		return fmt.Errorf("marshal stream chunk: %w", err)
+	}
+	chunks <- []byte(fmt.Sprintf("data: %s\n\n", body))
+	return nil
+}
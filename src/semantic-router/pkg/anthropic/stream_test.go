@@ -0,0 +1,129 @@
+package anthropic
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/anthropics/anthropic-sdk-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapStopReason(t *testing.T) {
+	assert.Equal(t, "length", mapStopReason(anthropic.StopReasonMaxTokens))
+	assert.Equal(t, "tool_calls", mapStopReason(anthropic.StopReasonToolUse))
+	assert.Equal(t, "stop", mapStopReason(anthropic.StopReasonEndTurn))
+}
+
+func TestEmitChunk_TextDelta(t *testing.T) {
+	client := &Client{}
+	chunks := make(chan []byte, 1)
+
+	err := client.emitChunk(chunks, "msg_123", "claude-sonnet-4-5", 1234, openAIStreamDelta{Content: "Hi"}, nil)
+	assert.NoError(t, err)
+
+	frame := <-chunks
+	assert.True(t, strings.HasPrefix(string(frame), "data: "))
+	assert.True(t, strings.HasSuffix(string(frame), "\n\n"))
+
+	var chunk openAIStreamChunk
+	body := strings.TrimSuffix(strings.TrimPrefix(string(frame), "data: "), "\n\n")
+	assert.NoError(t, json.Unmarshal([]byte(body), &chunk))
+	assert.Equal(t, "chat.completion.chunk", chunk.Object)
+	assert.Equal(t, "Hi", chunk.Choices[0].Delta.Content)
+	assert.Nil(t, chunk.Choices[0].FinishReason)
+}
+
+func TestEmitChunk_FinishReason(t *testing.T) {
+	client := &Client{}
+	chunks := make(chan []byte, 1)
+	finishReason := "tool_calls"
+
+	err := client.emitChunk(chunks, "msg_123", "claude-sonnet-4-5", 1234, openAIStreamDelta{}, &finishReason)
+	assert.NoError(t, err)
+
+	frame := <-chunks
+	assert.Contains(t, string(frame), `"finish_reason":"tool_calls"`)
+}
+
+// newStreamEvent builds a MessageStreamEventUnion of the given type,
+// leaving callers to fill in whichever nested fields that event type uses.
+func newStreamEvent(eventType string) anthropic.MessageStreamEventUnion {
+	var event anthropic.MessageStreamEventUnion
+	event.Type = eventType
+	return event
+}
+
+func TestHandleStreamEvent_FullSequence(t *testing.T) {
+	client := &Client{}
+	chunks := make(chan []byte, 16)
+	state := newStreamState(1234)
+
+	messageStart := newStreamEvent("message_start")
+	messageStart.Message.ID = "msg_1"
+	messageStart.Message.Usage.InputTokens = 10
+
+	textBlockStart := newStreamEvent("content_block_start")
+	textBlockStart.Index = 0
+	textBlockStart.ContentBlock.Type = "text"
+
+	textDelta := newStreamEvent("content_block_delta")
+	textDelta.Index = 0
+	textDelta.Delta.Type = "text_delta"
+	textDelta.Delta.Text = "Hello"
+
+	textBlockStop := newStreamEvent("content_block_stop")
+	textBlockStop.Index = 0
+
+	toolBlockStart := newStreamEvent("content_block_start")
+	toolBlockStart.Index = 1
+	toolBlockStart.ContentBlock.Type = "tool_use"
+	toolBlockStart.ContentBlock.ID = "toolu_1"
+	toolBlockStart.ContentBlock.Name = "get_weather"
+
+	toolArgsDeltaOne := newStreamEvent("content_block_delta")
+	toolArgsDeltaOne.Index = 1
+	toolArgsDeltaOne.Delta.Type = "input_json_delta"
+	toolArgsDeltaOne.Delta.PartialJSON = `{"location":`
+
+	toolArgsDeltaTwo := newStreamEvent("content_block_delta")
+	toolArgsDeltaTwo.Index = 1
+	toolArgsDeltaTwo.Delta.Type = "input_json_delta"
+	toolArgsDeltaTwo.Delta.PartialJSON = `"Paris"}`
+
+	toolBlockStop := newStreamEvent("content_block_stop")
+	toolBlockStop.Index = 1
+
+	messageDelta := newStreamEvent("message_delta")
+	messageDelta.Delta.StopReason = anthropic.StopReasonToolUse
+	messageDelta.Usage.OutputTokens = 42
+
+	events := []anthropic.MessageStreamEventUnion{
+		messageStart, textBlockStart, textDelta, textBlockStop,
+		toolBlockStart, toolArgsDeltaOne, toolArgsDeltaTwo, toolBlockStop,
+		messageDelta, newStreamEvent("message_stop"),
+	}
+
+	for _, event := range events {
+		assert.NoError(t, client.handleStreamEvent(event, "claude-sonnet-4-5", state, chunks))
+	}
+	close(chunks)
+
+	var frames []string
+	for frame := range chunks {
+		frames = append(frames, string(frame))
+	}
+
+	assert.Equal(t, "msg_1", state.id)
+	assert.Equal(t, "tool_calls", state.finishReason)
+	assert.Equal(t, int64(42), state.usage.OutputTokens)
+	assert.Equal(t, map[int64]int{1: 0}, state.toolCallIndexes)
+
+	// role chunk, text delta, tool_use start, and two input_json_delta chunks.
+	assert.Len(t, frames, 5)
+	assert.Contains(t, frames[0], `"role":"assistant"`)
+	assert.Contains(t, frames[1], `"content":"Hello"`)
+	assert.Contains(t, frames[2], `"id":"toolu_1"`)
+	assert.Contains(t, frames[3], `"arguments":"{\"location\":"`)
+	assert.Contains(t, frames[4], `"arguments":"\"Paris\"}"`)
+}
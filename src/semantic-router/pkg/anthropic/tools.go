@@ -0,0 +1,133 @@
+package anthropic
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/anthropics/anthropic-sdk-go"
+	"github.com/openai/openai-go"
+)
+
+// toAnthropicTools converts OpenAI tool definitions into Anthropic tool
+// definitions, decomposing the JSON-schema `parameters` object into
+// Anthropic's `input_schema` shape.
+func (c *Client) toAnthropicTools(tools []openai.ChatCompletionToolParam) []anthropic.ToolUnionParam {
+	if len(tools) == 0 {
+		return nil
+	}
+
+	result := make([]anthropic.ToolUnionParam, 0, len(tools))
+	for _, tool := range tools {
+		fn := tool.Function
+		result = append(result, anthropic.ToolUnionParam{
+			OfTool: &anthropic.ToolParam{
+				Name:        fn.Name,
+				Description: anthropic.String(fn.Description.Value),
+				InputSchema: toAnthropicInputSchema(fn.Parameters),
+			},
+		})
+	}
+	return result
+}
+
+// toAnthropicInputSchema decomposes an OpenAI JSON-schema `parameters`
+// object into Anthropic's input_schema: `properties` maps straight across,
+// and every other top-level key (most importantly `required`) is carried
+// through via ExtraFields so it isn't silently dropped from the schema sent
+// to Claude.
+func toAnthropicInputSchema(parameters openai.FunctionParameters) anthropic.ToolInputSchemaParam {
+	schema := anthropic.ToolInputSchemaParam{}
+	var extra map[string]interface{}
+	for key, value := range parameters {
+		switch key {
+		case "type":
+			// Anthropic's input_schema.type is already fixed to "object".
+			continue
+		case "properties":
+			schema.Properties = value
+		default:
+			if extra == nil {
+				extra = make(map[string]interface{})
+			}
+			extra[key] = value
+		}
+	}
+	if extra != nil {
+		schema.ExtraFields = extra
+	}
+	return schema
+}
+
+// toAnthropicToolChoice maps OpenAI's tool_choice (a bare string of "auto",
+// "none", "required", or a named-function object) onto Anthropic's
+// equivalent tagged union. Returns nil when the caller left tool_choice
+// unset, so the field is omitted from the request entirely.
+func (c *Client) toAnthropicToolChoice(choice openai.ChatCompletionToolChoiceOptionUnionParam) *anthropic.ToolChoiceUnionParam {
+	if name := choice.OfChatCompletionNamedToolChoice; name != nil {
+		return &anthropic.ToolChoiceUnionParam{
+			OfTool: &anthropic.ToolChoiceToolParam{Name: name.Function.Name},
+		}
+	}
+
+	switch choice.OfAuto.Value {
+	case "none":
+		return &anthropic.ToolChoiceUnionParam{OfNone: &anthropic.ToolChoiceNoneParam{}}
+	case "required":
+		return &anthropic.ToolChoiceUnionParam{OfAny: &anthropic.ToolChoiceAnyParam{}}
+	case "auto":
+		return &anthropic.ToolChoiceUnionParam{OfAuto: &anthropic.ToolChoiceAutoParam{}}
+	default:
+		return nil
+	}
+}
+
+// toAnthropicAssistantMessage converts an OpenAI assistant message into an
+// Anthropic assistant message, translating any `tool_calls` into `tool_use`
+// content blocks alongside the assistant's text (if any).
+func (c *Client) toAnthropicAssistantMessage(msg *openai.ChatCompletionAssistantMessageParam) anthropic.MessageParam {
+	if len(msg.ToolCalls) == 0 {
+		return anthropic.NewAssistantMessage(anthropic.NewTextBlock(c.extractAssistantContent(msg)))
+	}
+
+	var blocks []anthropic.ContentBlockParamUnion
+	if content := c.extractAssistantContent(msg); content != "" {
+		blocks = append(blocks, anthropic.NewTextBlock(content))
+	}
+	for _, call := range msg.ToolCalls {
+		var input map[string]interface{}
+		if err := json.Unmarshal([]byte(call.Function.Arguments), &input); err != nil {
+			input = map[string]interface{}{}
+		}
+		blocks = append(blocks, anthropic.NewToolUseBlock(call.ID, input, call.Function.Name))
+	}
+	return anthropic.NewAssistantMessage(blocks...)
+}
+
+// toAnthropicToolResultMessage converts a run of consecutive OpenAI
+// role=tool messages into a single Anthropic user message carrying one
+// `tool_result` block per message, matching each `tool_call_id` back up to
+// the `tool_use` block it answers. Anthropic's Messages API requires strict
+// user/assistant alternation, so parallel tool calls (which produce several
+// consecutive role=tool messages) must collapse into one user turn rather
+// than several.
+func (c *Client) toAnthropicToolResultMessage(msgs []*openai.ChatCompletionToolMessageParam) anthropic.MessageParam {
+	blocks := make([]anthropic.ContentBlockParamUnion, 0, len(msgs))
+	for _, msg := range msgs {
+		blocks = append(blocks, anthropic.NewToolResultBlock(msg.ToolCallID, c.extractToolContent(msg), false))
+	}
+	return anthropic.NewUserMessage(blocks...)
+}
+
+// extractToolContent extracts text from an OpenAI role=tool message.
+func (c *Client) extractToolContent(msg *openai.ChatCompletionToolMessageParam) string {
+	if msg.Content.OfString.Value != "" {
+		return msg.Content.OfString.Value
+	}
+	var parts []string
+	for _, part := range msg.Content.OfArrayOfContentParts {
+		if part.Text != "" {
+			parts = append(parts, part.Text)
+		}
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,72 @@
+package anthropic
+
+import (
+	"time"
+
+	"github.com/anthropics/anthropic-sdk-go"
+)
+
+// UsageRecord describes the token usage, cost, and latency of a single
+// completed call (blocking or streamed), handed to every registered
+// UsageObserver.
+type UsageRecord struct {
+	Model                    string
+	InputTokens              int64
+	OutputTokens             int64
+	CacheCreationInputTokens int64
+	CacheReadInputTokens     int64
+	Latency                  time.Duration
+	Cost                     float64
+}
+
+// UsageObserver receives a UsageRecord after each completed call, so
+// callers can enforce per-tenant budgets or feed dashboards without the
+// Client needing to know about them.
+type UsageObserver interface {
+	ObserveUsage(record UsageRecord)
+}
+
+// ModelPricing is the USD cost per 1M tokens for a given model, with
+// separate rates for cache writes and cache reads.
+type ModelPricing struct {
+	InputPerMillion      float64
+	OutputPerMillion     float64
+	CacheWritePerMillion float64
+	CacheReadPerMillion  float64
+}
+
+// PriceTable maps a model name to its ModelPricing.
+type PriceTable map[string]ModelPricing
+
+// cost computes the USD cost of usage against the table. A model with no
+// pricing entry costs 0 rather than erroring, so metering degrades
+// gracefully instead of blocking requests.
+func (t PriceTable) cost(model string, usage anthropic.Usage) float64 {
+	pricing, ok := t[model]
+	if !ok {
+		return 0
+	}
+	const perMillion = 1_000_000
+	return float64(usage.InputTokens)/perMillion*pricing.InputPerMillion +
+		float64(usage.OutputTokens)/perMillion*pricing.OutputPerMillion +
+		float64(usage.CacheCreationInputTokens)/perMillion*pricing.CacheWritePerMillion +
+		float64(usage.CacheReadInputTokens)/perMillion*pricing.CacheReadPerMillion
+}
+
+// recordUsage builds a UsageRecord from an Anthropic usage payload and
+// notifies the configured UsageObserver (if any) and Prometheus metrics.
+func (c *Client) recordUsage(model string, usage anthropic.Usage, latency time.Duration) {
+	record := UsageRecord{
+		Model:                    model,
+		InputTokens:              usage.InputTokens,
+		OutputTokens:             usage.OutputTokens,
+		CacheCreationInputTokens: usage.CacheCreationInputTokens,
+		CacheReadInputTokens:     usage.CacheReadInputTokens,
+		Latency:                  latency,
+		Cost:                     c.prices.cost(model, usage),
+	}
+	if c.observer != nil {
+		c.observer.ObserveUsage(record)
+	}
+	observeMetrics(record)
+}
@@ -0,0 +1,71 @@
+package anthropic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/anthropics/anthropic-sdk-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriceTable_Cost(t *testing.T) {
+	prices := PriceTable{
+		"claude-sonnet-4-5": {
+			InputPerMillion:      3,
+			OutputPerMillion:     15,
+			CacheWritePerMillion: 3.75,
+			CacheReadPerMillion:  0.3,
+		},
+	}
+
+	cost := prices.cost("claude-sonnet-4-5", anthropic.Usage{
+		InputTokens:              1_000_000,
+		OutputTokens:             1_000_000,
+		CacheCreationInputTokens: 1_000_000,
+		CacheReadInputTokens:     1_000_000,
+	})
+
+	assert.InDelta(t, 22.05, cost, 1e-9)
+}
+
+func TestPriceTable_Cost_UnknownModel(t *testing.T) {
+	prices := PriceTable{"claude-sonnet-4-5": {InputPerMillion: 3}}
+
+	cost := prices.cost("claude-haiku-4-5", anthropic.Usage{InputTokens: 1_000_000})
+
+	assert.Equal(t, 0.0, cost)
+}
+
+type recordingObserver struct {
+	records []UsageRecord
+}
+
+func (o *recordingObserver) ObserveUsage(record UsageRecord) {
+	o.records = append(o.records, record)
+}
+
+func TestClient_RecordUsage_NotifiesObserver(t *testing.T) {
+	observer := &recordingObserver{}
+	client := NewClient("test-api-key",
+		WithUsageObserver(observer),
+		WithPriceTable(PriceTable{"claude-sonnet-4-5": {InputPerMillion: 3, OutputPerMillion: 15}}),
+	)
+
+	client.recordUsage("claude-sonnet-4-5", anthropic.Usage{InputTokens: 1000, OutputTokens: 500}, 42*time.Millisecond)
+
+	assert.Len(t, observer.records, 1)
+	record := observer.records[0]
+	assert.Equal(t, "claude-sonnet-4-5", record.Model)
+	assert.Equal(t, int64(1000), record.InputTokens)
+	assert.Equal(t, int64(500), record.OutputTokens)
+	assert.Equal(t, 42*time.Millisecond, record.Latency)
+	assert.InDelta(t, 0.0105, record.Cost, 1e-9)
+}
+
+func TestClient_RecordUsage_NoObserverDoesNotPanic(t *testing.T) {
+	client := NewClient("test-api-key")
+
+	assert.NotPanics(t, func() {
+		client.recordUsage("claude-sonnet-4-5", anthropic.Usage{InputTokens: 10}, time.Millisecond)
+	})
+}
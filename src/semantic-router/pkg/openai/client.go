@@ -0,0 +1,60 @@
+// Package openai provides a client that forwards OpenAI-format requests to
+// the real OpenAI API unchanged, so it can be registered alongside other
+// backends behind the same Provider interface as the Anthropic adapter.
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	openaisdk "github.com/openai/openai-go"
+	"github.com/openai/openai-go/option"
+
+	"github.com/vllm-project/semantic-router/src/semantic-router/pkg/providers"
+)
+
+// Client wraps the OpenAI SDK. Unlike the Anthropic client, it does no
+// format translation: the request is already OpenAI-format.
+type Client struct {
+	sdk openaisdk.Client
+}
+
+// Client implements providers.Provider.
+var _ providers.Provider = (*Client)(nil)
+
+// NewClient creates a new OpenAI client.
+func NewClient(apiKey string) *Client {
+	return &Client{sdk: openaisdk.NewClient(option.WithAPIKey(apiKey))}
+}
+
+// ChatCompletion forwards req to OpenAI's Chat Completions API as-is.
+func (c *Client) ChatCompletion(ctx context.Context, req *openaisdk.ChatCompletionNewParams) ([]byte, error) {
+	resp, err := c.sdk.Chat.Completions.New(ctx, *req)
+	if err != nil {
+		return nil, fmt.Errorf("openai API error: %w", err)
+	}
+	return json.Marshal(resp)
+}
+
+// ChatCompletionStream forwards req to OpenAI's Chat Completions API with
+// streaming enabled, re-serializing each decoded chunk as an SSE frame on
+// chunks.
+func (c *Client) ChatCompletionStream(ctx context.Context, req *openaisdk.ChatCompletionNewParams, chunks chan<- []byte) error {
+	streamReq := *req
+	streamReq.Stream = openaisdk.Bool(true)
+
+	stream := c.sdk.Chat.Completions.NewStreaming(ctx, streamReq)
+	for stream.Next() {
+		body, err := json.Marshal(stream.Current())
+		if err != nil {
+			return fmt.Errorf("marshal openai stream chunk: %w", err)
+		}
+		chunks <- []byte(fmt.Sprintf("data: %s\n\n", body))
+	}
+	if err := stream.Err(); err != nil {
+		return fmt.Errorf("openai stream error: %w", err)
+	}
+	chunks <- []byte("data: [DONE]\n\n")
+	return nil
+}
@@ -0,0 +1,110 @@
+package providers
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/openai/openai-go"
+)
+
+// LocalProvider forwards OpenAI-format requests, unmodified, to any
+// OpenAI-compatible HTTP endpoint (a local vLLM, Ollama, or llama.cpp
+// server) over plain HTTP rather than a vendor SDK.
+type LocalProvider struct {
+	baseURL    string
+	apiKey     string
+	httpClient *http.Client
+}
+
+// LocalProvider implements Provider.
+var _ Provider = (*LocalProvider)(nil)
+
+// NewLocalProvider creates a LocalProvider pointed at an OpenAI-compatible
+// server's base URL (e.g. "http://localhost:8000/v1"). apiKey may be empty
+// for servers that don't require authentication.
+func NewLocalProvider(baseURL, apiKey string) *LocalProvider {
+	return &LocalProvider{baseURL: baseURL, apiKey: apiKey, httpClient: &http.Client{}}
+}
+
+// ChatCompletion posts req to <baseURL>/chat/completions and returns the
+// response body as-is.
+func (p *LocalProvider) ChatCompletion(ctx context.Context, req *openai.ChatCompletionNewParams) ([]byte, error) {
+	httpResp, err := p.post(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("local provider: read response: %w", err)
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("local provider returned %d: %s", httpResp.StatusCode, body)
+	}
+	return body, nil
+}
+
+// ChatCompletionStream posts req with streaming enabled and relays each SSE
+// line from the backend to chunks unmodified, stopping at the `[DONE]`
+// sentinel.
+func (p *LocalProvider) ChatCompletionStream(ctx context.Context, req *openai.ChatCompletionNewParams, chunks chan<- []byte) error {
+	streamReq := *req
+	streamReq.Stream = openai.Bool(true)
+
+	httpResp, err := p.post(ctx, &streamReq)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(httpResp.Body)
+		return fmt.Errorf("local provider returned %d: %s", httpResp.StatusCode, body)
+	}
+
+	scanner := bufio.NewScanner(httpResp.Body)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		// scanner.Bytes() aliases a buffer that bufio.Scanner reuses and
+		// overwrites on the next Scan(), so the frame must be copied before
+		// it crosses the channel to another goroutine.
+		frame := append([]byte(nil), line...)
+		frame = append(frame, '\n', '\n')
+		chunks <- frame
+		if bytes.Equal(line, []byte("data: [DONE]")) {
+			break
+		}
+	}
+	return scanner.Err()
+}
+
+func (p *LocalProvider) post(ctx context.Context, req *openai.ChatCompletionNewParams) (*http.Response, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("local provider: marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+"/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("local provider: build request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if p.apiKey != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+
+	resp, err := p.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("local provider: request failed: %w", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,81 @@
+package providers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/openai/openai-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalProvider_ChatCompletion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/chat/completions", r.URL.Path)
+		assert.Equal(t, "Bearer test-key", r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"local-1"}`))
+	}))
+	defer server.Close()
+
+	provider := NewLocalProvider(server.URL, "test-key")
+	resp, err := provider.ChatCompletion(context.Background(), &openai.ChatCompletionNewParams{Model: "llama-3-70b"})
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"id":"local-1"}`, string(resp))
+}
+
+func TestLocalProvider_ChatCompletion_NonOKStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte("boom"))
+	}))
+	defer server.Close()
+
+	provider := NewLocalProvider(server.URL, "")
+	_, err := provider.ChatCompletion(context.Background(), &openai.ChatCompletionNewParams{Model: "llama-3-70b"})
+
+	assert.Error(t, err)
+}
+
+func TestLocalProvider_ChatCompletionStream(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = w.Write([]byte("data: first-line-sent\n\n"))
+		_, _ = w.Write([]byte("data: SECOND-LINE-OVERWRITES\n\n"))
+		_, _ = w.Write([]byte("data: [DONE]\n\n"))
+	}))
+	defer server.Close()
+
+	provider := NewLocalProvider(server.URL, "test-key")
+
+	// An unbuffered channel forces each frame to sit in bufio.Scanner's
+	// reused buffer until the reader catches up, which is what exposed the
+	// aliasing bug: frames must be copied before being sent, not read only
+	// after the scan loop finishes.
+	chunks := make(chan []byte)
+	var frames [][]byte
+	done := make(chan error, 1)
+	go func() {
+		done <- provider.ChatCompletionStream(context.Background(), &openai.ChatCompletionNewParams{Model: "llama-3-70b"}, chunks)
+	}()
+	for i := 0; i < 3; i++ {
+		frames = append(frames, append([]byte(nil), <-chunks...))
+	}
+
+	assert.NoError(t, <-done)
+	assert.Equal(t, []string{
+		"data: first-line-sent\n\n",
+		"data: SECOND-LINE-OVERWRITES\n\n",
+		"data: [DONE]\n\n",
+	}, toStrings(frames))
+}
+
+func toStrings(frames [][]byte) []string {
+	result := make([]string, len(frames))
+	for i, frame := range frames {
+		result[i] = string(frame)
+	}
+	return result
+}
@@ -0,0 +1,25 @@
+// Package providers defines a pluggable backend abstraction so that a
+// single OpenAI-format `/v1/chat/completions` handler can dispatch across
+// heterogeneous chat completion backends (Anthropic, OpenAI, local
+// OpenAI-compatible servers, ...) without knowing which SDK serves a given
+// model.
+package providers
+
+import (
+	"context"
+
+	"github.com/openai/openai-go"
+)
+
+// Provider is implemented by every backend capable of serving an
+// OpenAI-format chat completion request.
+type Provider interface {
+	// ChatCompletion processes an OpenAI-format request and returns an
+	// OpenAI-format response body.
+	ChatCompletion(ctx context.Context, req *openai.ChatCompletionNewParams) ([]byte, error)
+
+	// ChatCompletionStream processes an OpenAI-format request with
+	// streaming enabled, emitting OpenAI-format `chat.completion.chunk`
+	// SSE frames on chunks as they become available.
+	ChatCompletionStream(ctx context.Context, req *openai.ChatCompletionNewParams, chunks chan<- []byte) error
+}
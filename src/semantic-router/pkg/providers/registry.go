@@ -0,0 +1,64 @@
+package providers
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// modelRule routes any model whose name starts with prefix to provider.
+type modelRule struct {
+	prefix   string
+	provider string
+}
+
+// Registry resolves an OpenAI-format model name to the Provider that should
+// serve it, either by exact provider name or by model-prefix rule (e.g.
+// "claude-" -> "anthropic", "gpt-" -> "openai").
+type Registry struct {
+	mu        sync.RWMutex
+	providers map[string]Provider
+	rules     []modelRule
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{providers: make(map[string]Provider)}
+}
+
+// Register associates a Provider with a name, so it can be targeted
+// directly or by a prefix rule added via RouteModelPrefix.
+func (r *Registry) Register(name string, provider Provider) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.providers[name] = provider
+}
+
+// RouteModelPrefix routes any model name starting with prefix to the
+// provider registered under providerName. Rules are evaluated in the order
+// they were added, so register more specific prefixes first.
+func (r *Registry) RouteModelPrefix(prefix, providerName string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.rules = append(r.rules, modelRule{prefix: prefix, provider: providerName})
+}
+
+// Resolve returns the Provider for model: first an exact match on the
+// provider name, then the first matching prefix rule whose provider is
+// registered. A matching rule that names an unregistered provider is skipped.
+func (r *Registry) Resolve(model string) (Provider, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if provider, ok := r.providers[model]; ok {
+		return provider, nil
+	}
+	for _, rule := range r.rules {
+		if strings.HasPrefix(model, rule.prefix) {
+			if provider, ok := r.providers[rule.provider]; ok {
+				return provider, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("providers: no provider registered for model %q", model)
+}
@@ -0,0 +1,72 @@
+package providers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openai/openai-go"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeProvider struct{ name string }
+
+func (f *fakeProvider) ChatCompletion(ctx context.Context, req *openai.ChatCompletionNewParams) ([]byte, error) {
+	return []byte(f.name), nil
+}
+
+func (f *fakeProvider) ChatCompletionStream(ctx context.Context, req *openai.ChatCompletionNewParams, chunks chan<- []byte) error {
+	return nil
+}
+
+func TestRegistry_ResolveByExactName(t *testing.T) {
+	registry := NewRegistry()
+	anthropic := &fakeProvider{name: "anthropic"}
+	registry.Register("anthropic", anthropic)
+
+	provider, err := registry.Resolve("anthropic")
+
+	assert.NoError(t, err)
+	assert.Same(t, anthropic, provider)
+}
+
+func TestRegistry_ResolveByModelPrefix(t *testing.T) {
+	registry := NewRegistry()
+	anthropicProvider := &fakeProvider{name: "anthropic"}
+	openaiProvider := &fakeProvider{name: "openai"}
+	registry.Register("anthropic", anthropicProvider)
+	registry.Register("openai", openaiProvider)
+	registry.RouteModelPrefix("claude-", "anthropic")
+	registry.RouteModelPrefix("gpt-", "openai")
+
+	resolved, err := registry.Resolve("claude-sonnet-4-5")
+	assert.NoError(t, err)
+	assert.Same(t, anthropicProvider, resolved)
+
+	resolved, err = registry.Resolve("gpt-4o")
+	assert.NoError(t, err)
+	assert.Same(t, openaiProvider, resolved)
+}
+
+func TestRegistry_ResolveUnknownModel(t *testing.T) {
+	registry := NewRegistry()
+	registry.RouteModelPrefix("claude-", "anthropic")
+
+	_, err := registry.Resolve("llama-3-70b")
+
+	assert.Error(t, err)
+}
+
+func TestRegistry_FirstMatchingPrefixWins(t *testing.T) {
+	registry := NewRegistry()
+	specific := &fakeProvider{name: "claude-opus"}
+	general := &fakeProvider{name: "anthropic"}
+	registry.Register("claude-opus", specific)
+	registry.Register("anthropic", general)
+	registry.RouteModelPrefix("claude-opus-", "claude-opus")
+	registry.RouteModelPrefix("claude-", "anthropic")
+
+	resolved, err := registry.Resolve("claude-opus-4-1")
+
+	assert.NoError(t, err)
+	assert.Same(t, specific, resolved)
+}